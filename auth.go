@@ -0,0 +1,340 @@
+package main
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const htpasswdReloadInterval = 5 * time.Second
+
+type AuthConfig struct {
+	Type             string   `yaml:"type"`
+	File             string   `yaml:"file"`
+	Realm            string   `yaml:"realm"`
+	HiddenDomain     string   `yaml:"hidden_domain"`
+	Tokens           []string `yaml:"tokens"`
+	IntrospectionURL string   `yaml:"introspection_url"`
+}
+
+// Authenticator validates inbound requests before they reach
+// getHealthyBackend. Challenge writes the response (status code and any
+// WWW-Authenticate header) for a request that failed Authenticate.
+type Authenticator interface {
+	Authenticate(r *http.Request) bool
+	Challenge(w http.ResponseWriter)
+}
+
+func newAuthenticator(cfg AuthConfig) (Authenticator, error) {
+	switch cfg.Type {
+	case "", "none":
+		return nil, nil
+	case "basic":
+		return newBasicAuthenticator(cfg)
+	case "bearer":
+		return newBearerAuthenticator(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown auth type %q", cfg.Type)
+	}
+}
+
+// authenticateRequest reports whether r should be rejected, and if so
+// whether the hidden domain should be used to mask that auth is enabled.
+func authenticateRequest(auth Authenticator, r *http.Request) bool {
+	if auth == nil {
+		return true
+	}
+	return auth.Authenticate(r)
+}
+
+// basicAuthenticator checks client-supplied HTTP Basic credentials against
+// an htpasswd file, which is polled for changes and reloaded in place.
+type basicAuthenticator struct {
+	path  string
+	realm string
+
+	mu      sync.RWMutex
+	creds   map[string]string
+	modTime time.Time
+}
+
+func newBasicAuthenticator(cfg AuthConfig) (*basicAuthenticator, error) {
+	if cfg.File == "" {
+		return nil, fmt.Errorf("auth: basic requires a file")
+	}
+	a := &basicAuthenticator{path: cfg.File, realm: cfg.Realm}
+	if err := a.reload(); err != nil {
+		return nil, err
+	}
+	go a.watch()
+	return a, nil
+}
+
+func (a *basicAuthenticator) watch() {
+	ticker := time.NewTicker(htpasswdReloadInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		info, err := os.Stat(a.path)
+		if err != nil {
+			if verbose {
+				log.Printf("Failed to stat htpasswd file %s: %v", a.path, err)
+			}
+			continue
+		}
+		if !info.ModTime().After(a.modTime) {
+			continue
+		}
+		if err := a.reload(); err != nil {
+			logCritical("Failed to reload htpasswd file %s: %v", a.path, err)
+		} else if verbose {
+			log.Printf("Reloaded htpasswd file %s", a.path)
+		}
+	}
+}
+
+func (a *basicAuthenticator) reload() error {
+	data, err := ioutil.ReadFile(a.path)
+	if err != nil {
+		return fmt.Errorf("failed to read htpasswd file: %v", err)
+	}
+	info, err := os.Stat(a.path)
+	if err != nil {
+		return err
+	}
+
+	creds := make(map[string]string)
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		user, hash, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		creds[user] = hash
+	}
+
+	a.mu.Lock()
+	a.creds = creds
+	a.modTime = info.ModTime()
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *basicAuthenticator) Authenticate(r *http.Request) bool {
+	user, pass, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+
+	a.mu.RLock()
+	hash, exists := a.creds[user]
+	a.mu.RUnlock()
+	if !exists {
+		return false
+	}
+
+	ok, err := verifyHtpasswdHash(hash, pass)
+	if err != nil {
+		if verbose {
+			log.Printf("htpasswd verify error for user %s: %v", user, err)
+		}
+		return false
+	}
+	return ok
+}
+
+func (a *basicAuthenticator) Challenge(w http.ResponseWriter) {
+	realm := a.realm
+	if realm == "" {
+		realm = "restricted"
+	}
+	w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Basic realm=%q`, realm))
+	http.Error(w, "Unauthorized", http.StatusUnauthorized)
+}
+
+// verifyHtpasswdHash supports the bcrypt, {SHA} and apr1-MD5 htpasswd hash
+// formats. Classic crypt(3) DES hashes are not supported.
+func verifyHtpasswdHash(hash, password string) (bool, error) {
+	switch {
+	case strings.HasPrefix(hash, "$2y$"), strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"):
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		return err == nil, nil
+	case strings.HasPrefix(hash, "{SHA}"):
+		sum := sha1.Sum([]byte(password))
+		return hash[len("{SHA}"):] == base64.StdEncoding.EncodeToString(sum[:]), nil
+	case strings.HasPrefix(hash, "$apr1$"):
+		return hash == apr1Crypt(password, hash), nil
+	default:
+		return false, fmt.Errorf("unsupported htpasswd hash format")
+	}
+}
+
+const apr1Alphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// apr1Crypt implements the Apache "apr1" MD5-crypt variant. existing may be
+// either a bare salt or a full "$apr1$salt$hash" string; only the salt
+// portion is used, so it can verify a password against an existing hash.
+func apr1Crypt(password, existing string) string {
+	salt := existing
+	if parts := strings.Split(existing, "$"); len(parts) >= 3 && parts[1] == "apr1" {
+		salt = parts[2]
+	}
+
+	ctx := md5.New()
+	ctx.Write([]byte(password))
+	ctx.Write([]byte("$apr1$"))
+	ctx.Write([]byte(salt))
+
+	alt := md5.New()
+	alt.Write([]byte(password))
+	alt.Write([]byte(salt))
+	alt.Write([]byte(password))
+	altSum := alt.Sum(nil)
+
+	for i := len(password); i > 0; i -= 16 {
+		if i > 16 {
+			ctx.Write(altSum)
+		} else {
+			ctx.Write(altSum[:i])
+		}
+	}
+
+	for i := len(password); i != 0; i >>= 1 {
+		if i&1 != 0 {
+			ctx.Write([]byte{0})
+		} else {
+			ctx.Write([]byte{password[0]})
+		}
+	}
+
+	sum := ctx.Sum(nil)
+
+	for i := 0; i < 1000; i++ {
+		round := md5.New()
+		if i&1 != 0 {
+			round.Write([]byte(password))
+		} else {
+			round.Write(sum)
+		}
+		if i%3 != 0 {
+			round.Write([]byte(salt))
+		}
+		if i%7 != 0 {
+			round.Write([]byte(password))
+		}
+		if i&1 != 0 {
+			round.Write(sum)
+		} else {
+			round.Write([]byte(password))
+		}
+		sum = round.Sum(nil)
+	}
+
+	encode := func(b [3]byte, n int) []byte {
+		v := uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+		out := make([]byte, n)
+		for i := 0; i < n; i++ {
+			out[i] = apr1Alphabet[v&0x3f]
+			v >>= 6
+		}
+		return out
+	}
+
+	result := make([]byte, 0, 22)
+	result = append(result, encode([3]byte{sum[0], sum[6], sum[12]}, 4)...)
+	result = append(result, encode([3]byte{sum[1], sum[7], sum[13]}, 4)...)
+	result = append(result, encode([3]byte{sum[2], sum[8], sum[14]}, 4)...)
+	result = append(result, encode([3]byte{sum[3], sum[9], sum[15]}, 4)...)
+	result = append(result, encode([3]byte{sum[4], sum[10], sum[5]}, 4)...)
+	result = append(result, encode([3]byte{0, 0, sum[11]}, 2)...)
+
+	return "$apr1$" + salt + "$" + string(result)
+}
+
+// bearerAuthenticator accepts a static list of tokens and/or validates
+// tokens against a remote introspection endpoint.
+type bearerAuthenticator struct {
+	tokens           map[string]struct{}
+	introspectionURL string
+}
+
+func newBearerAuthenticator(cfg AuthConfig) *bearerAuthenticator {
+	tokens := make(map[string]struct{}, len(cfg.Tokens))
+	for _, t := range cfg.Tokens {
+		tokens[t] = struct{}{}
+	}
+	return &bearerAuthenticator{tokens: tokens, introspectionURL: cfg.IntrospectionURL}
+}
+
+func (a *bearerAuthenticator) Authenticate(r *http.Request) bool {
+	authHeader := r.Header.Get("Authorization")
+	const prefix = "Bearer "
+	if !strings.HasPrefix(authHeader, prefix) {
+		return false
+	}
+	token := strings.TrimPrefix(authHeader, prefix)
+	if token == "" {
+		return false
+	}
+	if _, ok := a.tokens[token]; ok {
+		return true
+	}
+	if a.introspectionURL != "" {
+		return a.introspect(token)
+	}
+	return false
+}
+
+func (a *bearerAuthenticator) introspect(token string) bool {
+	client := http.Client{Timeout: httpTimeout}
+	req, err := http.NewRequest("POST", a.introspectionURL, strings.NewReader(url.Values{"token": {token}}.Encode()))
+	if err != nil {
+		return false
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		if verbose {
+			log.Printf("Token introspection request failed: %v", err)
+		}
+		return false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+
+	// RFC 7662: the endpoint answers 200 OK for both valid and invalid
+	// tokens, distinguishing them only via the "active" field in the body.
+	var body struct {
+		Active bool `json:"active"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		if verbose {
+			log.Printf("Failed to decode token introspection response: %v", err)
+		}
+		return false
+	}
+	return body.Active
+}
+
+func (a *bearerAuthenticator) Challenge(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	http.Error(w, "Unauthorized", http.StatusUnauthorized)
+}
@@ -0,0 +1,47 @@
+package main
+
+import "testing"
+
+// TestApr1Crypt pins the hand-rolled apr1-MD5-crypt implementation against
+// a hash produced by `openssl passwd -apr1 -salt r31..... mypassword`.
+func TestApr1Crypt(t *testing.T) {
+	const (
+		password = "mypassword"
+		salt     = "$apr1$r31.....$"
+		want     = "$apr1$r31.....$m64iYGgXzxb6ITlX6CP3J."
+	)
+
+	if got := apr1Crypt(password, salt); got != want {
+		t.Errorf("apr1Crypt(%q, %q) = %q, want %q", password, salt, got, want)
+	}
+}
+
+func TestVerifyHtpasswdHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		want     bool
+	}{
+		{"apr1 correct password", "$apr1$r31.....$m64iYGgXzxb6ITlX6CP3J.", "mypassword", true},
+		{"apr1 wrong password", "$apr1$r31.....$m64iYGgXzxb6ITlX6CP3J.", "wrongpassword", false},
+		{"sha correct password", "{SHA}qUqP5cyxm6YcTAhz05Hph5gvu9M=", "test", true},
+		{"sha wrong password", "{SHA}qUqP5cyxm6YcTAhz05Hph5gvu9M=", "nope", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verifyHtpasswdHash(tt.hash, tt.password)
+			if err != nil {
+				t.Fatalf("verifyHtpasswdHash returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("verifyHtpasswdHash(%q, %q) = %v, want %v", tt.hash, tt.password, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := verifyHtpasswdHash("$6$unsupported$hash", "x"); err == nil {
+		t.Error("verifyHtpasswdHash should reject unsupported hash formats")
+	}
+}
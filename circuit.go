@@ -0,0 +1,120 @@
+package main
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Circuit breaker states for Backend.circuitState.
+const (
+	circuitClosed int32 = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type CircuitBreakerConfig struct {
+	Threshold   int           `yaml:"threshold"`
+	Window      time.Duration `yaml:"window"`
+	BaseBackoff time.Duration `yaml:"base_backoff"`
+	MaxBackoff  time.Duration `yaml:"max_backoff"`
+}
+
+func circuitThreshold() int32 {
+	if config.CircuitBreaker.Threshold > 0 {
+		return int32(config.CircuitBreaker.Threshold)
+	}
+	return 5
+}
+
+func circuitWindow() time.Duration {
+	if config.CircuitBreaker.Window > 0 {
+		return config.CircuitBreaker.Window
+	}
+	return 30 * time.Second
+}
+
+func circuitBaseBackoff() time.Duration {
+	if config.CircuitBreaker.BaseBackoff > 0 {
+		return config.CircuitBreaker.BaseBackoff
+	}
+	return 5 * time.Second
+}
+
+func circuitMaxBackoff() time.Duration {
+	if config.CircuitBreaker.MaxBackoff > 0 {
+		return config.CircuitBreaker.MaxBackoff
+	}
+	return 2 * time.Minute
+}
+
+// circuitAllow reports whether a request may be sent to backend. A closed
+// circuit always allows it; an open one only allows it once the
+// exponential backoff has elapsed. It never mutates circuitState: this is
+// called while filtering every backend on every request, and a backend
+// merely considered eligible here isn't necessarily the one the request
+// ends up routed to. See circuitEnterTrial for the half-open transition.
+func circuitAllow(backend *Backend) bool {
+	state := atomic.LoadInt32(&backend.circuitState)
+	if state == circuitClosed {
+		return true
+	}
+	if state == circuitHalfOpen {
+		return false
+	}
+
+	backoff := circuitBaseBackoff() << uint(atomic.LoadInt32(&backend.openCount))
+	if max := circuitMaxBackoff(); backoff > max {
+		backoff = max
+	}
+
+	openedAt := time.Unix(0, atomic.LoadInt64(&backend.openedAt))
+	return time.Since(openedAt) >= backoff
+}
+
+// circuitEnterTrial moves an open backend whose backoff has elapsed into
+// the half-open state, letting a single in-flight request act as the
+// trial. Call this only for the backend selectBackend actually chose for
+// the current request, never while filtering candidates in
+// getHealthyBackend — otherwise every request that merely glances at the
+// backend during filtering would consume the one trial slot, and since
+// selectBackend usually routes elsewhere, the backend could flip to
+// half-open without ever having its outcome recorded, stranding it there
+// forever.
+func circuitEnterTrial(backend *Backend) {
+	atomic.CompareAndSwapInt32(&backend.circuitState, circuitOpen, circuitHalfOpen)
+}
+
+// circuitRecordSuccess closes the circuit and resets its failure history.
+func circuitRecordSuccess(backend *Backend) {
+	atomic.StoreInt32(&backend.consecutiveFailures, 0)
+	atomic.StoreInt32(&backend.openCount, 0)
+	atomic.StoreInt32(&backend.circuitState, circuitClosed)
+}
+
+// circuitRecordFailure counts a 5xx/connection error toward the breaker.
+// Failures outside the configured window don't accumulate. Reaching the
+// threshold opens the circuit; failing the half-open trial reopens it
+// with a longer backoff.
+func circuitRecordFailure(backend *Backend) {
+	now := time.Now()
+
+	if atomic.LoadInt32(&backend.circuitState) == circuitHalfOpen {
+		atomic.AddInt32(&backend.openCount, 1)
+		atomic.StoreInt64(&backend.openedAt, now.UnixNano())
+		atomic.StoreInt64(&backend.lastFailureAt, now.UnixNano())
+		atomic.StoreInt32(&backend.circuitState, circuitOpen)
+		return
+	}
+
+	last := atomic.SwapInt64(&backend.lastFailureAt, now.UnixNano())
+	if last == 0 || now.Sub(time.Unix(0, last)) > circuitWindow() {
+		atomic.StoreInt32(&backend.consecutiveFailures, 1)
+	} else {
+		atomic.AddInt32(&backend.consecutiveFailures, 1)
+	}
+
+	if atomic.LoadInt32(&backend.consecutiveFailures) >= circuitThreshold() &&
+		atomic.CompareAndSwapInt32(&backend.circuitState, circuitClosed, circuitOpen) {
+		atomic.StoreInt64(&backend.openedAt, now.UnixNano())
+	}
+}
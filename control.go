@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/tluyben/go-proxy/proto/control"
+)
+
+var knownStrategies = map[string]bool{
+	strategyRandom:             true,
+	strategyRoundRobin:         true,
+	strategyWeightedRoundRobin: true,
+	strategyLeastConnections:   true,
+	strategyConsistentHash:     true,
+}
+
+// controlServer implements pb.ProxyControlServer against the running
+// proxy's in-memory config, so operators can reconfigure it without a
+// restart.
+type controlServer struct{}
+
+func serveControlAPI(port int) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to listen on admin port %d: %v", port, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterProxyControlServer(grpcServer, &controlServer{})
+
+	log.Printf("Starting control API on port %d", port)
+	return grpcServer.Serve(lis)
+}
+
+func (s *controlServer) AddBackend(ctx context.Context, req *pb.AddBackendRequest) (*pb.AddBackendResponse, error) {
+	if req.Url == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	backend := &Backend{URL: req.Url, Weight: int(req.Weight)}
+	if err := resolveBackendURL(backend); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid backend url: %v", err)
+	}
+
+	configMu.Lock()
+	config.Backends = append(config.Backends, backend)
+	configMu.Unlock()
+
+	log.Printf("Control API: added backend %s (weight %d)", backend.URL, backend.Weight)
+	return &pb.AddBackendResponse{Backend: toPbBackend(backend)}, nil
+}
+
+func (s *controlServer) RemoveBackend(ctx context.Context, req *pb.RemoveBackendRequest) (*pb.RemoveBackendResponse, error) {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	for i, b := range config.Backends {
+		if b.URL == req.Url {
+			config.Backends = append(config.Backends[:i], config.Backends[i+1:]...)
+			log.Printf("Control API: removed backend %s", req.Url)
+			return &pb.RemoveBackendResponse{}, nil
+		}
+	}
+	for p := range config.Pools {
+		pool := &config.Pools[p]
+		for i, b := range pool.Backends {
+			if b.URL == req.Url {
+				pool.Backends = append(pool.Backends[:i], pool.Backends[i+1:]...)
+				log.Printf("Control API: removed backend %s from pool %s", req.Url, pool.Name)
+				return &pb.RemoveBackendResponse{}, nil
+			}
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "backend %s not found", req.Url)
+}
+
+func (s *controlServer) DrainBackend(ctx context.Context, req *pb.DrainBackendRequest) (*pb.DrainBackendResponse, error) {
+	backend, err := findBackend(req.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	atomic.StoreInt32(&backend.draining, 1)
+	log.Printf("Control API: draining backend %s", req.Url)
+	return &pb.DrainBackendResponse{Backend: toPbBackend(backend)}, nil
+}
+
+func (s *controlServer) SetStrategy(ctx context.Context, req *pb.SetStrategyRequest) (*pb.SetStrategyResponse, error) {
+	if !knownStrategies[req.Strategy] {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown strategy %q", req.Strategy)
+	}
+
+	configMu.Lock()
+	config.Strategy = req.Strategy
+	configMu.Unlock()
+
+	log.Printf("Control API: strategy set to %s", req.Strategy)
+	return &pb.SetStrategyResponse{Strategy: req.Strategy}, nil
+}
+
+func (s *controlServer) RotateBearerToken(ctx context.Context, req *pb.RotateBearerTokenRequest) (*pb.RotateBearerTokenResponse, error) {
+	configMu.Lock()
+	config.BearerToken = req.NewToken
+	configMu.Unlock()
+
+	log.Printf("Control API: bearer token rotated")
+	return &pb.RotateBearerTokenResponse{}, nil
+}
+
+func (s *controlServer) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.StatsResponse, error) {
+	configMu.RLock()
+	backends := make([]*Backend, len(config.Backends))
+	copy(backends, config.Backends)
+	strategy := config.Strategy
+	configMu.RUnlock()
+
+	resp := &pb.StatsResponse{Strategy: strategy}
+	for _, b := range backends {
+		resp.Backends = append(resp.Backends, &pb.BackendStats{
+			Url:               b.URL,
+			Healthy:           atomic.LoadInt32(&b.Health) == 1,
+			Draining:          atomic.LoadInt32(&b.draining) == 1,
+			ActiveConnections: atomic.LoadInt32(&b.activeConns),
+			RequestsTotal:     atomic.LoadInt64(&b.requestsTotal),
+			Requests_2Xx:      atomic.LoadInt64(&b.requests2xx),
+			Requests_4Xx:      atomic.LoadInt64(&b.requests4xx),
+			Requests_5Xx:      atomic.LoadInt64(&b.requests5xx),
+		})
+	}
+	return resp, nil
+}
+
+// findBackend looks up a backend by URL across the default pool and all
+// named pools (see allBackends), so control API calls can reach pool
+// members too, not just top-level config.Backends.
+func findBackend(rawURL string) (*Backend, error) {
+	for _, b := range allBackends() {
+		if b.URL == rawURL {
+			return b, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "backend %s not found", rawURL)
+}
+
+func toPbBackend(b *Backend) *pb.Backend {
+	return &pb.Backend{
+		Url:               b.URL,
+		Weight:            int32(b.Weight),
+		Healthy:           atomic.LoadInt32(&b.Health) == 1,
+		Draining:          atomic.LoadInt32(&b.draining) == 1,
+		ActiveConnections: atomic.LoadInt32(&b.activeConns),
+	}
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type HealthCheckConfig struct {
+	Method       string `yaml:"method"`
+	ExpectStatus string `yaml:"expect_status"`
+	BodyRegex    string `yaml:"body_regex"`
+	Protocol     string `yaml:"protocol"`
+}
+
+var (
+	healthStatusRanges [][2]int
+	healthBodyRegex    *regexp.Regexp
+)
+
+// parseStatusRanges parses a comma-separated list of status codes and
+// ranges, e.g. "200-299,304", into inclusive [lo, hi] pairs. An empty spec
+// means "200 only", matching the proxy's original behavior.
+func parseStatusRanges(spec string) ([][2]int, error) {
+	if spec == "" {
+		return [][2]int{{http.StatusOK, http.StatusOK}}, nil
+	}
+
+	var ranges [][2]int
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if lo, hi, found := strings.Cut(part, "-"); found {
+			loCode, err := strconv.Atoi(strings.TrimSpace(lo))
+			if err != nil {
+				return nil, fmt.Errorf("invalid status range %q: %v", part, err)
+			}
+			hiCode, err := strconv.Atoi(strings.TrimSpace(hi))
+			if err != nil {
+				return nil, fmt.Errorf("invalid status range %q: %v", part, err)
+			}
+			ranges = append(ranges, [2]int{loCode, hiCode})
+		} else {
+			code, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, fmt.Errorf("invalid status code %q: %v", part, err)
+			}
+			ranges = append(ranges, [2]int{code, code})
+		}
+	}
+	return ranges, nil
+}
+
+func statusMatches(ranges [][2]int, status int) bool {
+	for _, r := range ranges {
+		if status >= r[0] && status <= r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// probeBackend runs the configured active health probe against backend:
+// an HTTP request by default, or a bare TCP dial when the backend isn't
+// speaking HTTP at all.
+func probeBackend(backend *Backend) bool {
+	if config.HealthCheck.Protocol == "tcp" {
+		return probeTCP(backend)
+	}
+	return probeHTTP(backend)
+}
+
+func probeHTTP(backend *Backend) bool {
+	method := config.HealthCheck.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	path := backend.HealthPath
+	if path == "" {
+		path = config.Health
+	}
+	healthURL := backend.URL + path
+
+	req, err := http.NewRequest(method, healthURL, nil)
+	if err != nil {
+		if verbose {
+			log.Printf("Failed to create health check request for %s: %v", healthURL, err)
+		}
+		return false
+	}
+	configMu.RLock()
+	bearerToken := config.BearerToken
+	configMu.RUnlock()
+	if bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+
+	client := http.Client{Timeout: httpTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		if verbose {
+			log.Printf("Health check failed for %s: %v", healthURL, err)
+		}
+		return false
+	}
+	defer resp.Body.Close()
+
+	if !statusMatches(healthStatusRanges, resp.StatusCode) {
+		if verbose {
+			log.Printf("Health check for %s got unexpected status %d", healthURL, resp.StatusCode)
+		}
+		return false
+	}
+
+	if healthBodyRegex != nil {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			if verbose {
+				log.Printf("Failed to read health check response body for %s: %v", healthURL, err)
+			}
+			return false
+		}
+		if !healthBodyRegex.Match(body) {
+			if verbose {
+				log.Printf("Health check body for %s did not match %s", healthURL, config.HealthCheck.BodyRegex)
+			}
+			return false
+		}
+	}
+
+	return true
+}
+
+func probeTCP(backend *Backend) bool {
+	addr := net.JoinHostPort(backend.Host, backend.Port)
+	conn, err := net.DialTimeout("tcp", addr, httpTimeout)
+	if err != nil {
+		if verbose {
+			log.Printf("TCP health check failed for %s: %v", addr, err)
+		}
+		return false
+	}
+	conn.Close()
+	return true
+}
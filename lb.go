@@ -0,0 +1,170 @@
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	strategyRandom             = "random"
+	strategyRoundRobin         = "round_robin"
+	strategyWeightedRoundRobin = "weighted_round_robin"
+	strategyLeastConnections   = "least_connections"
+	strategyConsistentHash     = "consistent_hash"
+
+	hashRingReplicas = 100
+)
+
+var (
+	rrMu      sync.Mutex
+	rrIndexes = map[string]int{}
+
+	wrrMu sync.Mutex
+)
+
+// selectBackend picks a backend from healthy according to the configured
+// strategy. r may be nil (e.g. health checks don't need it); strategies
+// that rely on request data fall back to random in that case. poolKey
+// identifies which backend set healthy was drawn from (a pool name, or
+// defaultPoolKey), so round-robin state doesn't get shared across
+// unrelated sets.
+func selectBackend(strategy string, healthy []*Backend, r *http.Request, poolKey string) *Backend {
+	switch strategy {
+	case strategyRoundRobin:
+		return pickRoundRobin(poolKey, healthy)
+	case strategyWeightedRoundRobin:
+		return pickWeightedRoundRobin(healthy)
+	case strategyLeastConnections:
+		return pickLeastConnections(healthy)
+	case strategyConsistentHash:
+		return pickConsistentHash(healthy, r)
+	default:
+		return healthy[rand.Intn(len(healthy))]
+	}
+}
+
+// pickRoundRobin advances and returns the round-robin index kept for
+// poolKey. Keying per pool/default-set (rather than one shared index)
+// keeps the rotation stable as the healthy slice's length and membership
+// vary independently across pools and health churn.
+func pickRoundRobin(poolKey string, healthy []*Backend) *Backend {
+	rrMu.Lock()
+	defer rrMu.Unlock()
+	idx := (rrIndexes[poolKey] + 1) % len(healthy)
+	rrIndexes[poolKey] = idx
+	return healthy[idx]
+}
+
+// pickWeightedRoundRobin implements the smooth weighted round-robin
+// algorithm: every backend's currentWeight is bumped by its weight, the
+// backend with the highest currentWeight is chosen, and the total weight
+// is subtracted back off the winner. This spreads picks evenly instead of
+// clumping all requests onto the heaviest backend in a row.
+func pickWeightedRoundRobin(healthy []*Backend) *Backend {
+	wrrMu.Lock()
+	defer wrrMu.Unlock()
+
+	var total int32
+	var best *Backend
+	for _, b := range healthy {
+		weight := int32(b.Weight)
+		if weight <= 0 {
+			weight = 1
+		}
+		b.currentWeight += weight
+		total += weight
+		if best == nil || b.currentWeight > best.currentWeight {
+			best = b
+		}
+	}
+	best.currentWeight -= total
+	return best
+}
+
+func pickLeastConnections(healthy []*Backend) *Backend {
+	best := healthy[0]
+	bestConns := atomic.LoadInt32(&best.activeConns)
+	for _, b := range healthy[1:] {
+		if conns := atomic.LoadInt32(&b.activeConns); conns < bestConns {
+			best = b
+			bestConns = conns
+		}
+	}
+	return best
+}
+
+// pickConsistentHash maps the request's sticky key (client IP, or a
+// configured header/cookie) onto a hash ring built from the currently
+// healthy backends so repeat requests from the same client land on the
+// same backend as long as it stays healthy.
+func pickConsistentHash(healthy []*Backend, r *http.Request) *Backend {
+	key := stickyKey(r)
+	if key == "" {
+		return healthy[rand.Intn(len(healthy))]
+	}
+
+	ring := buildHashRing(healthy, hashRingReplicas)
+	return ring.get(key)
+}
+
+func stickyKey(r *http.Request) string {
+	if r == nil {
+		return ""
+	}
+	if config.Sticky.Header != "" {
+		if v := r.Header.Get(config.Sticky.Header); v != "" {
+			return v
+		}
+	}
+	if config.Sticky.Cookie != "" {
+		if c, err := r.Cookie(config.Sticky.Cookie); err == nil && c.Value != "" {
+			return c.Value
+		}
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+type hashRing struct {
+	points []uint32
+	nodes  map[uint32]*Backend
+}
+
+func buildHashRing(backends []*Backend, replicas int) *hashRing {
+	ring := &hashRing{nodes: make(map[uint32]*Backend, len(backends)*replicas)}
+	for _, b := range backends {
+		for i := 0; i < replicas; i++ {
+			h := hashString(fmt.Sprintf("%s#%d", b.URL, i))
+			ring.points = append(ring.points, h)
+			ring.nodes[h] = b
+		}
+	}
+	sort.Slice(ring.points, func(i, j int) bool { return ring.points[i] < ring.points[j] })
+	return ring
+}
+
+func (ring *hashRing) get(key string) *Backend {
+	if len(ring.points) == 0 {
+		return nil
+	}
+	h := hashString(key)
+	idx := sort.Search(len(ring.points), func(i int) bool { return ring.points[i] >= h })
+	if idx == len(ring.points) {
+		idx = 0
+	}
+	return ring.nodes[ring.points[idx]]
+}
+
+func hashString(s string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return h.Sum32()
+}
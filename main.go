@@ -12,11 +12,14 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"os"
+	"regexp"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/getsentry/sentry-go"
+	"golang.org/x/net/http2"
 	"gopkg.in/yaml.v2"
 )
 
@@ -24,7 +27,33 @@ type Backend struct {
 	URL    string `yaml:"url"`
 	Host   string
 	Port   string
-	Health int32 // 0 for down, 1 for up
+	Health     int32  // 0 for down, 1 for up
+	Weight     int    `yaml:"weight"`
+	HealthPath string `yaml:"health_path"`
+
+	currentWeight int32
+	activeConns   int32
+	draining      int32 // 0 normal, 1 draining: ineligible for new requests, in-flight ones finish
+
+	// Per-backend request counters, surfaced over the control API's
+	// GetStats alongside the Prometheus metrics in metrics.go.
+	requestsTotal int64
+	requests2xx   int64
+	requests4xx   int64
+	requests5xx   int64
+
+	// Passive circuit breaker state, driven by real request outcomes in
+	// proxyHandler as well as the active checker in health.go.
+	consecutiveFailures int32
+	lastFailureAt       int64 // unix nano of the last counted failure
+	circuitState        int32 // circuitClosed/circuitOpen/circuitHalfOpen
+	openedAt            int64 // unix nano when the circuit last opened
+	openCount           int32 // consecutive opens, drives exponential backoff
+}
+
+type StickyConfig struct {
+	Header string `yaml:"header"`
+	Cookie string `yaml:"cookie"`
 }
 
 type Config struct {
@@ -32,7 +61,19 @@ type Config struct {
 	Interval 				int       `yaml:"interval"`
 	Health   				string    `yaml:"health"`
 	BearerToken   			string `yaml:"bearer_token,omitempty"`
-	Backends 				[]Backend `yaml:"backends"`
+	Backends 				[]*Backend `yaml:"backends"`
+	AdminPort 				int       `yaml:"admin_port"`
+	Strategy 				string    `yaml:"strategy"`
+	Sticky   				StickyConfig `yaml:"sticky"`
+	HTTP2    				bool      `yaml:"http2"`
+	FlushInterval 			time.Duration `yaml:"flush_interval"`
+	Auth     				AuthConfig `yaml:"auth"`
+	MetricsPath 			string    `yaml:"metrics_path"`
+	LogFormat 				string    `yaml:"log_format"`
+	HealthCheck 			HealthCheckConfig `yaml:"health_check"`
+	CircuitBreaker 			CircuitBreakerConfig `yaml:"circuit_breaker"`
+	TLS      				TLSConfig `yaml:"tls"`
+	Pools    				[]Pool    `yaml:"pools"`
 
 	DialTimeout 			time.Duration `yaml:"dial_timeout"`
 	DialKeepAlive   		time.Duration `yaml:"keep_alive"`
@@ -49,6 +90,13 @@ var (
 	dnsMu      sync.RWMutex
 	verbose    bool
 	sentryDSN  string
+	authenticator Authenticator
+	accessLogger  AccessLogger
+
+	// configMu guards config.Backends and config.Strategy/BearerToken, which
+	// can change at runtime via the control API while healthCheck and
+	// proxyHandler goroutines read them concurrently.
+	configMu sync.RWMutex
 )
 
 const (
@@ -80,6 +128,13 @@ func main() {
 		log.Printf("Loaded configuration: %+v", config)
 	}
 
+	authenticator, err = newAuthenticator(config.Auth)
+	if err != nil {
+		log.Fatalf("Failed to initialize auth: %v", err)
+	}
+
+	accessLogger = newAccessLogger(config.LogFormat, os.Stdout)
+
 	// Initialize Sentry if DSN is provided
 	if sentryDSN != "" {
 		err := sentry.Init(sentry.ClientOptions{
@@ -93,9 +148,24 @@ func main() {
 
 	go healthCheck()
 
-	http.HandleFunc("/", proxyHandler)
+	if config.AdminPort != 0 {
+		go func() {
+			if err := serveControlAPI(config.AdminPort); err != nil {
+				logCritical("Control API server stopped: %v", err)
+			}
+		}()
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath(), metricsHandler())
+	mux.HandleFunc("/", proxyHandler)
+
+	if config.TLS.enabled() {
+		log.Fatal(serveTLS(mux))
+	}
+
 	log.Printf("Starting proxy server on port %d", config.Port)
-	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", config.Port), nil))
+	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", config.Port), mux))
 }
 
 func logFatal(format string, v ...interface{}) {
@@ -136,20 +206,54 @@ func loadConfig() error {
 	if config.Health == "" {
 		config.Health = "/health"
 	}
+	if config.Strategy == "" {
+		config.Strategy = strategyRandom
+	}
 
-	for i, backend := range config.Backends {
-		parsedURL, err := url.Parse(backend.URL)
-		if err != nil {
+	for _, backend := range config.Backends {
+		if err := resolveBackendURL(backend); err != nil {
 			log.Printf("Failed to parse backend URL %s: %v", backend.URL, err)
-			continue
 		}
-		config.Backends[i].Host = parsedURL.Hostname()
-		config.Backends[i].Port = parsedURL.Port()
-		if config.Backends[i].Port == "" {
-			config.Backends[i].Port = "80"
+	}
+
+	ranges, err := parseStatusRanges(config.HealthCheck.ExpectStatus)
+	if err != nil {
+		return fmt.Errorf("invalid health_check.expect_status: %v", err)
+	}
+	healthStatusRanges = ranges
+
+	if config.HealthCheck.BodyRegex != "" {
+		re, err := regexp.Compile(config.HealthCheck.BodyRegex)
+		if err != nil {
+			return fmt.Errorf("invalid health_check.body_regex: %v", err)
 		}
+		healthBodyRegex = re
+	}
+
+	if err := indexPools(); err != nil {
+		return fmt.Errorf("invalid pools config: %v", err)
+	}
+
+	return nil
+}
+
+// resolveBackendURL fills in a backend's derived Host/Port fields and
+// normalizes its Weight. It's shared by initial config load and the
+// control API's AddBackend.
+func resolveBackendURL(backend *Backend) error {
+	if backend.Weight <= 0 {
+		backend.Weight = 1
 	}
 
+	parsedURL, err := url.Parse(backend.URL)
+	if err != nil {
+		return err
+	}
+	backend.Host = parsedURL.Hostname()
+	backend.Port = parsedURL.Port()
+	if backend.Port == "" {
+		backend.Port = "80"
+	}
 	return nil
 }
 
@@ -157,9 +261,11 @@ func resolveHostWithTimeout(host string) (string, error) {
 	dnsMu.RLock()
 	if ip, ok := dnsCache[host]; ok {
 		dnsMu.RUnlock()
+		dnsCacheTotal.WithLabelValues("hit").Inc()
 		return ip, nil
 	}
 	dnsMu.RUnlock()
+	dnsCacheTotal.WithLabelValues("miss").Inc()
 
 	ctx, cancel := context.WithTimeout(context.Background(), dnsTimeout)
 	defer cancel()
@@ -185,61 +291,35 @@ func resolveHostWithTimeout(host string) (string, error) {
 
 func healthCheck() {
 	for {
-		for i, backend := range config.Backends {
-			go func(i int, backend Backend) {
-				healthURL := fmt.Sprintf("%s%s", backend.URL, config.Health)
-				client := http.Client{Timeout: httpTimeout}
-				
-				// Create a new request to add headers
-				req, err := http.NewRequest("GET", healthURL, nil)
-				if err != nil {
-					if verbose {
-						log.Printf("Failed to create health check request for %s: %v", healthURL, err)
-					}
-					atomic.StoreInt32(&config.Backends[i].Health, 0)
-					return
+		backends := allBackends()
+
+		for _, backend := range backends {
+			go func(backend *Backend) {
+				healthy := probeBackend(backend)
+				setBackendHealth(backend, healthy)
+				if healthy {
+					circuitRecordSuccess(backend)
 				}
-
-				// Add bearer token if present
-				if config.BearerToken != "" {
-					req.Header.Set("Authorization", "Bearer "+config.BearerToken)
-					if verbose {
-						log.Printf("Added bearer token to health check request")
-					}
-				}
-
-				resp, err := client.Do(req)
-				if err != nil {
-					if verbose {
-						log.Printf("Health check failed for %s: %v", healthURL, err)
-					}
-					atomic.StoreInt32(&config.Backends[i].Health, 0)
-					return
-				}
-				defer resp.Body.Close()
-
-				if resp.StatusCode == http.StatusOK {
-					atomic.StoreInt32(&config.Backends[i].Health, 1)
-					if verbose {
+				if verbose {
+					if healthy {
 						log.Printf("Backend %s is healthy", backend.URL)
-					}
-				} else {
-					atomic.StoreInt32(&config.Backends[i].Health, 0)
-					if verbose {
-						log.Printf("Backend %s is unhealthy, status code: %d", backend.URL, resp.StatusCode)
+					} else {
+						log.Printf("Backend %s is unhealthy", backend.URL)
 					}
 				}
-			}(i, backend)
+			}(backend)
 		}
 		time.Sleep(time.Duration(config.Interval) * time.Second)
 	}
 }
 
-func getHealthyBackend() (*Backend, error) {
-	healthyBackends := make([]*Backend, 0)
-	for i := range config.Backends {
-		if atomic.LoadInt32(&config.Backends[i].Health) == 1 {
-			healthyBackends = append(healthyBackends, &config.Backends[i])
+func getHealthyBackend(r *http.Request) (*Backend, error) {
+	backends, strategy, poolKey := resolvePool(r)
+
+	healthyBackends := make([]*Backend, 0, len(backends))
+	for _, b := range backends {
+		if atomic.LoadInt32(&b.Health) == 1 && atomic.LoadInt32(&b.draining) == 0 && circuitAllow(b) {
+			healthyBackends = append(healthyBackends, b)
 		}
 	}
 
@@ -248,16 +328,55 @@ func getHealthyBackend() (*Backend, error) {
 		return nil, fmt.Errorf("no healthy backends available")
 	}
 
-	return healthyBackends[rand.Intn(len(healthyBackends))], nil
+	picked := selectBackend(strategy, healthyBackends, r, poolKey)
+	circuitEnterTrial(picked)
+	return picked, nil
 }
 
 func proxyHandler(w http.ResponseWriter, r *http.Request) {
 	startTime := time.Now()
+	var backend *Backend
+
+	rec := &statusRecorder{ResponseWriter: w}
+	w = rec
+	defer func() {
+		status := rec.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		backendLabel := ""
+		if backend != nil {
+			backendLabel = backend.URL
+			recordBackendRequest(backend, status)
+		}
+		requestsTotal.WithLabelValues(backendLabel, statusClass(status)).Inc()
+		requestDuration.WithLabelValues(backendLabel).Observe(time.Since(startTime).Seconds())
+		accessLogger.Log(AccessLogEntry{
+			Method:   r.Method,
+			Path:     r.URL.Path,
+			Backend:  backendLabel,
+			Status:   status,
+			Duration: time.Since(startTime),
+			Bytes:    rec.bytes,
+			ClientIP: clientIP(r),
+		})
+	}()
+
 	if verbose {
 		log.Printf("Received request for %s", r.URL.Path)
 	}
 
-	backend, err := getHealthyBackend()
+	if !authenticateRequest(authenticator, r) {
+		if config.Auth.HiddenDomain != "" && strings.EqualFold(r.Host, config.Auth.HiddenDomain) {
+			http.NotFound(w, r)
+		} else {
+			authenticator.Challenge(w)
+		}
+		return
+	}
+
+	var err error
+	backend, err = getHealthyBackend(r)
 	if err != nil {
 		if verbose {
 			log.Printf("No healthy backends available: %v", err)
@@ -277,14 +396,32 @@ func proxyHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if isWebSocketUpgrade(r) {
+		if verbose {
+			log.Printf("Tunneling WebSocket upgrade to %s", backend.URL)
+		}
+		defer trackActiveRequest(backend)()
+		if err := proxyWebSocket(w, r, backend, backendURL); err != nil {
+			logCritical("WebSocket proxy error: %v", err)
+			circuitRecordFailure(backend)
+		} else {
+			circuitRecordSuccess(backend)
+		}
+		return
+	}
+
 	proxy := httputil.NewSingleHostReverseProxy(backendURL)
-	
+
 	// Modify the director to add bearer token if present
+	configMu.RLock()
+	bearerToken := config.BearerToken
+	configMu.RUnlock()
+
 	originalDirector := proxy.Director
 	proxy.Director = func(req *http.Request) {
 		originalDirector(req)
-		if config.BearerToken != "" {
-			req.Header.Set("Authorization", "Bearer "+config.BearerToken)
+		if bearerToken != "" {
+			req.Header.Set("Authorization", "Bearer "+bearerToken)
 			if verbose {
 				log.Printf("Added bearer token to request")
 			}
@@ -324,7 +461,7 @@ func proxyHandler(w http.ResponseWriter, r *http.Request) {
 		expectContinueTimeout = config.ExpectContinueTimeout
 	}
 
-	proxy.Transport = &http.Transport{
+	transport := &http.Transport{
 		Dial: (&net.Dialer{
 			Timeout:   timeout * time.Second,
 			KeepAlive: keepalive * time.Second,
@@ -334,11 +471,27 @@ func proxyHandler(w http.ResponseWriter, r *http.Request) {
 		ExpectContinueTimeout: expectContinueTimeout * time.Second,
 	}
 
+	if config.HTTP2 {
+		if err := http2.ConfigureTransport(transport); err != nil {
+			logCritical("Failed to configure HTTP/2 transport: %v", err)
+		}
+	}
+
+	proxy.Transport = transport
+	proxy.FlushInterval = config.FlushInterval
+
 	if verbose {
 		log.Printf("Proxying request to %s", backend.URL)
 	}
+	defer trackActiveRequest(backend)()
 	proxy.ServeHTTP(w, r)
 
+	if rec.status >= 500 {
+		circuitRecordFailure(backend)
+	} else if rec.status != 0 {
+		circuitRecordSuccess(backend)
+	}
+
 	if verbose {
 		log.Printf("Request completed in %v", time.Since(startTime))
 	}
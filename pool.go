@@ -0,0 +1,85 @@
+package main
+
+import (
+	"log"
+	"net"
+	"net/http"
+)
+
+// Pool is a named group of backends reachable under one or more
+// hostnames/SNI values, for routing different domains to different
+// backend sets behind a single proxy instance.
+type Pool struct {
+	Name     string     `yaml:"name"`
+	Hosts    []string   `yaml:"hosts"`
+	Backends []*Backend `yaml:"backends"`
+	Strategy string     `yaml:"strategy"`
+}
+
+// poolsByHost indexes config.Pools by each configured host, built once in
+// loadConfig. It's read-only afterwards, so it needs no locking.
+var poolsByHost map[string]*Pool
+
+func indexPools() error {
+	poolsByHost = make(map[string]*Pool, len(config.Pools))
+	for i := range config.Pools {
+		pool := &config.Pools[i]
+		for _, host := range pool.Hosts {
+			poolsByHost[host] = pool
+		}
+		for _, backend := range pool.Backends {
+			if backend.Weight <= 0 {
+				backend.Weight = 1
+			}
+			if err := resolveBackendURL(backend); err != nil {
+				log.Printf("Failed to parse backend URL %s in pool %s: %v", backend.URL, pool.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// defaultPoolKey identifies the top-level default backend set to
+// round-robin state, distinct from any named pool (see resolvePool).
+const defaultPoolKey = "default"
+
+// resolvePool returns the backends, strategy, and round-robin key to serve
+// r with, based on its Host header: a named pool if one of its Hosts
+// matches, falling back to the top-level default backends/strategy
+// otherwise. The key identifies which backend set round-robin state
+// belongs to, since pools and the default set are selected from
+// independently.
+func resolvePool(r *http.Request) ([]*Backend, string, string) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	configMu.RLock()
+	defer configMu.RUnlock()
+
+	if pool, ok := poolsByHost[host]; ok {
+		strategy := pool.Strategy
+		if strategy == "" {
+			strategy = config.Strategy
+		}
+		return pool.Backends, strategy, pool.Name
+	}
+
+	return config.Backends, config.Strategy, defaultPoolKey
+}
+
+// allBackends returns every backend across the default pool and all named
+// pools, for the active health checker to probe.
+func allBackends() []*Backend {
+	configMu.RLock()
+	defer configMu.RUnlock()
+
+	backends := make([]*Backend, len(config.Backends))
+	copy(backends, config.Backends)
+
+	for _, pool := range config.Pools {
+		backends = append(backends, pool.Backends...)
+	}
+	return backends
+}
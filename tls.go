@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"golang.org/x/crypto/acme/autocert"
+)
+
+type TLSConfig struct {
+	CertFile     string   `yaml:"cert_file"`
+	KeyFile      string   `yaml:"key_file"`
+	ACME         bool     `yaml:"acme"`
+	ACMECacheDir string   `yaml:"acme_cache_dir"`
+	ACMEHosts    []string `yaml:"acme_hosts"`
+	HTTPPort     int      `yaml:"http_port"`
+}
+
+func (t TLSConfig) enabled() bool {
+	return t.ACME || t.CertFile != ""
+}
+
+// serveTLS runs the HTTPS listener for handler, either with a static
+// cert/key pair or with certificates issued on demand via ACME.
+func serveTLS(handler http.Handler) error {
+	if config.TLS.ACME {
+		return serveACME(handler)
+	}
+
+	addr := fmt.Sprintf(":%d", config.Port)
+	log.Printf("Starting HTTPS proxy server on %s", addr)
+	return http.ListenAndServeTLS(addr, config.TLS.CertFile, config.TLS.KeyFile, handler)
+}
+
+func serveACME(handler http.Handler) error {
+	cacheDir := config.TLS.ACMECacheDir
+	if cacheDir == "" {
+		cacheDir = "acme-cache"
+	}
+
+	manager := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache(cacheDir),
+		HostPolicy: autocert.HostWhitelist(config.TLS.ACMEHosts...),
+	}
+
+	httpPort := config.TLS.HTTPPort
+	if httpPort == 0 {
+		httpPort = 80
+	}
+
+	go func() {
+		httpAddr := fmt.Sprintf(":%d", httpPort)
+		log.Printf("Starting ACME HTTP-01 challenge/redirect listener on %s", httpAddr)
+		if err := http.ListenAndServe(httpAddr, manager.HTTPHandler(httpsRedirectHandler())); err != nil {
+			logCritical("ACME HTTP listener failed: %v", err)
+		}
+	}()
+
+	server := &http.Server{
+		Addr:      fmt.Sprintf(":%d", config.Port),
+		Handler:   handler,
+		TLSConfig: manager.TLSConfig(),
+	}
+	log.Printf("Starting HTTPS proxy server on %s (ACME)", server.Addr)
+	return server.ListenAndServeTLS("", "")
+}
+
+func httpsRedirectHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target := "https://" + r.Host + r.URL.RequestURI()
+		http.Redirect(w, r, target, http.StatusMovedPermanently)
+	})
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// isWebSocketUpgrade reports whether r is an HTTP Upgrade request for the
+// websocket protocol, per RFC 6455.
+func isWebSocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
+		strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade")
+}
+
+// proxyWebSocket hijacks the client connection and tunnels raw bytes
+// bidirectionally to backendURL, since an upgraded connection is no longer
+// plain request/response and can't be forwarded through the reverse proxy's
+// transport. It blocks until either side closes the connection.
+func proxyWebSocket(w http.ResponseWriter, r *http.Request, backend *Backend, backendURL *url.URL) error {
+	addr := net.JoinHostPort(backend.Host, backend.Port)
+
+	var backendConn net.Conn
+	var err error
+	if backendURL.Scheme == "https" || backendURL.Scheme == "wss" {
+		backendConn, err = tls.DialWithDialer(&net.Dialer{Timeout: httpTimeout}, "tcp", addr, &tls.Config{ServerName: backend.Host})
+	} else {
+		backendConn, err = net.DialTimeout("tcp", addr, httpTimeout)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to dial backend %s: %v", addr, err)
+	}
+	defer backendConn.Close()
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		return fmt.Errorf("response writer does not support hijacking")
+	}
+	clientConn, _, err := hijacker.Hijack()
+	if err != nil {
+		return fmt.Errorf("failed to hijack client connection: %v", err)
+	}
+	defer clientConn.Close()
+
+	r.URL.Scheme = backendURL.Scheme
+	r.URL.Host = backendURL.Host
+	if err := r.Write(backendConn); err != nil {
+		return fmt.Errorf("failed to forward upgrade request to backend: %v", err)
+	}
+
+	done := make(chan struct{}, 2)
+	pump := func(dst io.Writer, src io.Reader) {
+		io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go pump(backendConn, clientConn)
+	go pump(clientConn, backendConn)
+	<-done
+
+	return nil
+}